@@ -0,0 +1,68 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNDeliversExactCount(t *testing.T) {
+	g, err := NewGenerator(WithCharacters(LowerCaseAlphabet, 1), WithConcurrency(4))
+	require.Nil(t, err)
+
+	results, err := g.GenerateN(context.Background(), 200, 8, 8)
+	require.Nil(t, err)
+
+	count := 0
+	for r := range results {
+		require.Nil(t, r.Err)
+		require.Len(t, r.Password, 8)
+		count++
+	}
+	require.Equal(t, 200, count)
+}
+
+func TestGenerateNRejectsNonPositiveCount(t *testing.T) {
+	g, err := NewGenerator(WithCharacters(LowerCaseAlphabet, 1))
+	require.Nil(t, err)
+
+	_, err = g.GenerateN(context.Background(), 0, 8, 8)
+	require.NotNil(t, err)
+}
+
+func TestGenerateNClosesPromptlyOnCancellation(t *testing.T) {
+	g, err := NewGenerator(WithCharacters(LowerCaseAlphabet, 1))
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := g.GenerateN(ctx, 10000, 8, 8)
+	require.Nil(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		for range results {
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("GenerateN channel did not close promptly after context cancellation")
+	}
+}
+
+func TestBufferedRandomProducesUsableGenerator(t *testing.T) {
+	g, err := NewGenerator(WithCharacters(LowerCaseAlphabet, 1), WithBufferedRandom(64))
+	require.Nil(t, err)
+
+	for i := 0; i < 1000; i++ {
+		p, err := g.Generate(8, 8)
+		require.Nil(t, err)
+		require.Len(t, p, 8)
+	}
+}
@@ -0,0 +1,40 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivedGeneratorIsDeterministicPerSite(t *testing.T) {
+	master := []byte("correct horse battery staple")
+	g, err := NewDerivedGenerator(
+		master,
+		WithCharacters(LowerCaseAlphabet, 1),
+		WithCharacters(Digits, 1),
+	)
+	require.Nil(t, err)
+
+	p1, err := g.DeriveForSite("example.com", 12, 12)
+	require.Nil(t, err)
+	p2, err := g.DeriveForSite("example.com", 12, 12)
+	require.Nil(t, err)
+	require.Equal(t, p1, p2)
+
+	p3, err := g.DeriveForSite("other.example.com", 12, 12)
+	require.Nil(t, err)
+	require.NotEqual(t, p1, p3)
+}
+
+func TestDerivedGeneratorDiffersPerMasterSecret(t *testing.T) {
+	g1, err := NewDerivedGenerator([]byte("secret-a"), WithCharacters(LowerCaseAlphabet, 1))
+	require.Nil(t, err)
+	g2, err := NewDerivedGenerator([]byte("secret-b"), WithCharacters(LowerCaseAlphabet, 1))
+	require.Nil(t, err)
+
+	p1, err := g1.DeriveForSite("example.com", 16, 16)
+	require.Nil(t, err)
+	p2, err := g2.DeriveForSite("example.com", 16, 16)
+	require.Nil(t, err)
+	require.NotEqual(t, p1, p2)
+}
@@ -0,0 +1,189 @@
+package password
+
+import (
+	"fmt"
+	"math"
+)
+
+// EntropyUnsupportedErr is returned by Entropy for generators configured with
+// AlgoPronounceable: the uniform-draw model Entropy computes doesn't describe passwords
+// assembled from the syllable grammar's weighted, adjacency-constrained unit tables, and
+// reporting a number anyway would just be confidently wrong.
+var EntropyUnsupportedErr = fmt.Errorf("entropy estimation is not supported for pronounceable-algorithm generators")
+
+// Strength classifies a password's bit strength using the thresholds commonly cited in NIST
+// password-guidance discussions: below 28 bits is crackable offline in practical time, 28-35
+// is weak-but-usable, 36-59 is adequate for most purposes, and 60+ resists sustained offline
+// attack.
+type Strength int
+
+const (
+	Weak Strength = iota
+	Fair
+	Strong
+	VeryStrong
+)
+
+// String returns a human-readable name for s.
+func (s Strength) String() string {
+	switch s {
+	case Weak:
+		return "weak"
+	case Fair:
+		return "fair"
+	case Strong:
+		return "strong"
+	case VeryStrong:
+		return "very strong"
+	default:
+		return "unknown"
+	}
+}
+
+// strengthThresholds holds the lower bit bound for Fair, Strong and VeryStrong respectively.
+var strengthThresholds = [3]float64{28, 36, 60}
+
+// StrengthFor classifies bits into a Strength using strengthThresholds.
+func StrengthFor(bits float64) Strength {
+	switch {
+	case bits < strengthThresholds[0]:
+		return Weak
+	case bits < strengthThresholds[1]:
+		return Fair
+	case bits < strengthThresholds[2]:
+		return Strong
+	default:
+		return VeryStrong
+	}
+}
+
+// Entropy returns the minimum and maximum bits of entropy a password produced by g.Generate
+// could have, for the given length bounds. The bit count is the log2 of the number of
+// distinct passwords the generator can produce at that length: a password of length n is
+// reachable iff, for every configured WithCharacters set, at least that set's minimum count
+// of the final password's characters come from it (getRandomChars can always attribute
+// exactly min of them to that set's guaranteed draw and leave the rest to the free draw,
+// regardless of final order, since the result is fully shuffled). This model assumes
+// character sets don't overlap, so it returns EntropyUnsupportedErr for AlgoPronounceable
+// generators, whose output follows a weighted, adjacency-constrained syllable grammar instead.
+func (g *generator) Entropy(min, max uint) (bitsMin, bitsMax float64, err error) {
+	if g.algorithm == AlgoPronounceable {
+		return 0, 0, EntropyUnsupportedErr
+	}
+	if max < min || max < g.min {
+		return 0, 0, InvalidLengthErr
+	}
+	if min < g.min {
+		min = g.min
+	}
+
+	bitsMin = g.entropyAt(min)
+	bitsMax = g.entropyAt(max)
+	return bitsMin, bitsMax, nil
+}
+
+// entropyAt returns the bits of entropy for a password of exactly length n produced from g's
+// configured character sets: log2 of the sum, over every way of splitting n into one count
+// per charset (each count at least that charset's minimum), of the multinomial arrangement
+// of those counts times the number of ways to fill them from their sets. A naive per-charset
+// multinomial that instead treats the guaranteed-minimum and free draws as separately
+// countable overstates this: when a free-drawn character lands in a set that already met its
+// minimum, the resulting raw draw and the one where that character had filled the minimum
+// slot instead are indistinguishable once shuffled, and counting both as distinct produces a
+// number of raw draws rather than of distinct passwords. The sum above is computed via
+// convolution in log2 space (summing, per charset, every split of the n-g.min "free"
+// characters it could absorb) so it stays exact without overflowing for long passwords.
+func (g *generator) entropyAt(n uint) float64 {
+	if n < g.min {
+		return 0
+	}
+	free := n - g.min
+
+	// ways[t] is log2 of the sum, across every way of handing out t of the free characters
+	// among the charsets processed so far, of prod(size_i^(min_i+extra_i) / (min_i+extra_i)!)
+	// over those charsets — the per-charset "pick these characters, in this multiset" weight.
+	ways := make([]float64, free+1)
+	for t := range ways {
+		ways[t] = math.Inf(-1)
+	}
+	ways[0] = 0
+
+	for _, c := range g.charsets {
+		size := float64(len(c.set))
+		next := make([]float64, free+1)
+		for t := range next {
+			next[t] = math.Inf(-1)
+		}
+		for e := uint(0); e <= free; e++ {
+			count := c.min + e
+			var logWeight float64
+			if size == 0 {
+				if count != 0 {
+					continue
+				}
+				logWeight = 0
+			} else {
+				logWeight = float64(count)*math.Log2(size) - log2Factorial(count)
+			}
+			for t := e; t <= free; t++ {
+				if math.IsInf(ways[t-e], -1) {
+					continue
+				}
+				next[t] = log2Sum(next[t], ways[t-e]+logWeight)
+			}
+		}
+		ways = next
+	}
+
+	if math.IsInf(ways[free], -1) {
+		return 0
+	}
+	return log2Factorial(n) + ways[free]
+}
+
+// log2Sum returns log2(2^a + 2^b) without computing 2^a or 2^b directly, so it stays accurate
+// even when a or b is large enough that exponentiating it would overflow float64.
+func log2Sum(a, b float64) float64 {
+	if math.IsInf(a, -1) {
+		return b
+	}
+	if math.IsInf(b, -1) {
+		return a
+	}
+	if a < b {
+		a, b = b, a
+	}
+	return a + math.Log2(1+math.Pow(2, b-a))
+}
+
+// log2Factorial returns log2(n!), computed via the log-gamma function to avoid overflow for
+// large n.
+func log2Factorial(n uint) float64 {
+	logGamma, _ := math.Lgamma(float64(n) + 1)
+	return logGamma / math.Ln2
+}
+
+// EstimateEntropy returns the Shannon entropy, in bits, of the rune distribution actually
+// observed in p: -sum(f(r) * log2(f(r))) over each distinct rune r's frequency f(r),
+// multiplied by len(p). Unlike Entropy, which reasons about the generator's configuration,
+// this measures a specific password as given, so it's useful for scoring passwords a user
+// typed in rather than ones this package generated.
+func EstimateEntropy(p []rune) float64 {
+	if len(p) == 0 {
+		return 0
+	}
+
+	counts := make(map[rune]int, len(p))
+	for _, r := range p {
+		counts[r]++
+	}
+
+	n := float64(len(p))
+	var bitsPerRune float64
+	for _, count := range counts {
+		f := float64(count) / n
+		bitsPerRune -= f * math.Log2(f)
+	}
+
+	return bitsPerRune * n
+}
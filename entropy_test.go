@@ -0,0 +1,44 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntropyIncreasesWithLength(t *testing.T) {
+	g, err := NewGenerator(
+		WithCharacters(LowerCaseAlphabet, 1),
+		WithCharacters(Digits, 1),
+	)
+	require.Nil(t, err)
+
+	bitsMin, bitsMax, err := g.Entropy(8, 16)
+	require.Nil(t, err)
+	require.Greater(t, bitsMin, 0.0)
+	require.Greater(t, bitsMax, bitsMin)
+
+	_, _, err = g.Entropy(20, 10)
+	require.Equal(t, InvalidLengthErr, err)
+}
+
+func TestEntropyUnsupportedForPronounceable(t *testing.T) {
+	g, err := NewGenerator(WithAlgorithm(AlgoPronounceable))
+	require.Nil(t, err)
+
+	_, _, err = g.Entropy(8, 16)
+	require.Equal(t, EntropyUnsupportedErr, err)
+}
+
+func TestEstimateEntropy(t *testing.T) {
+	require.Equal(t, 0.0, EstimateEntropy(nil))
+	require.Equal(t, 0.0, EstimateEntropy([]rune("aaaa")))
+	require.Greater(t, EstimateEntropy([]rune("abcd")), EstimateEntropy([]rune("aabb")))
+}
+
+func TestStrengthForThresholds(t *testing.T) {
+	require.Equal(t, Weak, StrengthFor(10))
+	require.Equal(t, Fair, StrengthFor(30))
+	require.Equal(t, Strong, StrengthFor(40))
+	require.Equal(t, VeryStrong, StrengthFor(100))
+}
@@ -1,6 +1,7 @@
 package password
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
 	"math/big"
@@ -21,6 +22,22 @@ var (
 type Generator interface {
 	// Generate returns a password of a random length between min and max, or an error.
 	Generate(min, max uint) ([]rune, error)
+
+	// GenerateWithHints is like Generate, but also returns a hyphenated breakdown of the
+	// syllable units used to assemble the password (e.g. "tha-ib-wo"), for display as a
+	// pronunciation hint. The hint is empty when the generator isn't using AlgoPronounceable.
+	GenerateWithHints(min, max uint) ([]rune, string, error)
+
+	// Entropy returns the minimum and maximum bits of entropy a password produced by
+	// Generate could have, for the given length bounds, without generating one. Callers can
+	// use this to reject a configuration that can't reach a target bit strength up front.
+	// Returns EntropyUnsupportedErr for AlgoPronounceable generators.
+	Entropy(min, max uint) (bitsMin, bitsMax float64, err error)
+
+	// GenerateN generates count passwords concurrently across a worker pool sized by
+	// WithConcurrency, streaming results back over the returned channel as they complete.
+	// The channel is closed once count results have been sent or ctx is done.
+	GenerateN(ctx context.Context, count int, min, max uint) (<-chan Result, error)
 }
 
 // Options represents the type of options accepted by the password generator. See below for
@@ -58,9 +75,11 @@ func NewGenerator(opts ...Options) (Generator, error) {
 	for _, o := range opts {
 		o(g)
 	}
-	if g.num == 0 {
+	// AlgoPronounceable doesn't draw from g.charsets/g.num to generate; they're only
+	// consulted by applyMinimums, which already no-ops when none are declared. So a bare
+	// pronounceable generator with no WithCharacters is valid, unlike AlgoRandom.
+	if g.num == 0 && g.algorithm != AlgoPronounceable {
 		return nil, NoCharactersErr
-
 	}
 	return g, nil
 }
@@ -72,34 +91,86 @@ type charSet struct {
 
 // generator is a password generator that implements the Generator internface.
 type generator struct {
-	charsets []charSet
-	min      uint   // minimum length of a password.
-	num      uint   // max length of a password.
-	random   Random // optional random number generator.
+	charsets    []charSet
+	min         uint      // minimum length of a password.
+	num         uint      // total number of characters across all character sets.
+	random      Random    // optional random number generator.
+	algorithm   Algorithm // character-set-based or pronounceable.
+	policy      *PolicyOptions
+	concurrency int // number of workers GenerateN fans out across.
 }
 
 // Generate returns a password of a random length between min and max, or an error.
 func (g *generator) Generate(min, max uint) ([]rune, error) {
+	chars, _, err := g.generate(min, max)
+	return chars, err
+}
+
+// GenerateWithHints returns a password of a random length between min and max, along with a
+// hyphenated breakdown of the syllable units used to build it (only meaningful for
+// AlgoPronounceable; empty for AlgoRandom).
+func (g *generator) GenerateWithHints(min, max uint) ([]rune, string, error) {
+	return g.generate(min, max)
+}
+
+// generate builds a password of a random length between min and max using the configured
+// algorithm, along with the pronunciation hint (if any). If a policy is configured via
+// WithPolicy, the result is checked against it and regenerated (from scratch, including the
+// length) up to PolicyOptions.MaxRetries times before giving up.
+func (g *generator) generate(min, max uint) ([]rune, string, error) {
+	retries := uint(0)
+	if g.policy != nil {
+		retries = g.policy.MaxRetries
+	}
 
+	for attempt := uint(0); ; attempt++ {
+		chars, hints, err := g.generateOnce(min, max)
+		if err != nil {
+			return nil, "", err
+		}
+		if g.satisfiesPolicy(chars) {
+			return chars, hints, nil
+		}
+		if attempt >= retries {
+			return nil, "", PolicyUnsatisfiableErr
+		}
+	}
+}
+
+// generateOnce builds a single candidate password of a random length between min and max,
+// without applying the policy check/retry loop.
+func (g *generator) generateOnce(min, max uint) ([]rune, string, error) {
 	// Generate a password of random length.
 	length, err := g.getRandomLength(min, max)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	if g.algorithm == AlgoPronounceable {
+		chars, unitLens, err := g.generatePronounceable(length)
+		if err != nil {
+			return nil, "", err
+		}
+		chars, err = g.applyMinimums(chars)
+		if err != nil {
+			return nil, "", err
+		}
+		return chars, hintFromChars(chars, unitLens), nil
 	}
 
 	// Select the characters at random.
 	chars, err := g.getRandomChars(length)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Shuffle the characters.
 	err = shuffle(g.random, chars)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return chars, nil
+	return chars, "", nil
 }
 
 // getRandomLength returns a random number between min and max (uniform distribution).
@@ -0,0 +1,122 @@
+package password
+
+import "fmt"
+
+// PolicyUnsatisfiableErr is returned when no password satisfying the configured policy could
+// be produced in the first attempt plus PolicyOptions.MaxRetries retries.
+var PolicyUnsatisfiableErr = fmt.Errorf("unable to generate a password satisfying the configured policy")
+
+// defaultMaxRetries is used when PolicyOptions.MaxRetries is left at zero.
+const defaultMaxRetries = 10
+
+// PolicyOptions configures additional checks applied to a password after it is generated, on
+// top of the minimums declared via WithCharacters.
+type PolicyOptions struct {
+	// MaxRepeats is the maximum number of times the same rune may appear consecutively. Zero
+	// disables the check.
+	MaxRepeats uint
+
+	// NoSequential rejects passwords containing a run of 3 or more sequential characters
+	// from the alphabet or digits, e.g. "abc" or "123".
+	NoSequential bool
+
+	// Exclude lists runes that must never appear in a generated password.
+	Exclude []rune
+
+	// MaxRetries is how many additional attempts to make before giving up with
+	// PolicyUnsatisfiableErr. Defaults to 10 if zero.
+	MaxRetries uint
+}
+
+// WithPolicy adds a policy that every generated password must satisfy, regenerating up to
+// PolicyOptions.MaxRetries times if it doesn't. This mirrors APG's post-generation
+// minimum-requirement check: it guards against the rare but real case where selection,
+// shuffling or syllable assembly produces output that's technically valid but weak, and lets
+// callers bolt on site-specific rules without forking the generator.
+func WithPolicy(p PolicyOptions) Options {
+	return func(g *generator) {
+		if p.MaxRetries == 0 {
+			p.MaxRetries = defaultMaxRetries
+		}
+		g.policy = &p
+	}
+}
+
+// satisfiesPolicy reports whether chars satisfies the generator's configured policy, including
+// the minimums declared via WithCharacters.
+func (g *generator) satisfiesPolicy(chars []rune) bool {
+	if g.policy == nil {
+		return true
+	}
+
+	for _, cs := range g.charsets {
+		found := uint(0)
+		for _, c := range chars {
+			for _, r := range cs.set {
+				if c == r {
+					found++
+					break
+				}
+			}
+		}
+		if found < cs.min {
+			return false
+		}
+	}
+
+	excluded := make(map[rune]bool, len(g.policy.Exclude))
+	for _, r := range g.policy.Exclude {
+		excluded[r] = true
+	}
+
+	run := uint(1)
+	for i, c := range chars {
+		if excluded[c] {
+			return false
+		}
+		if i > 0 && c == chars[i-1] {
+			run++
+			if g.policy.MaxRepeats > 0 && run > g.policy.MaxRepeats {
+				return false
+			}
+		} else {
+			run = 1
+		}
+	}
+
+	if g.policy.NoSequential && hasSequentialRun(chars, 3) {
+		return false
+	}
+
+	return true
+}
+
+// hasSequentialRun reports whether chars contains a run of n or more consecutive letters
+// ("abc") or digits ("123"), in natural alphabet order.
+func hasSequentialRun(chars []rune, n int) bool {
+	run := 1
+	for i := 1; i < len(chars); i++ {
+		if isSequentialPair(chars[i-1], chars[i]) {
+			run++
+			if run >= n {
+				return true
+			}
+		} else {
+			run = 1
+		}
+	}
+	return false
+}
+
+// isSequentialPair reports whether b immediately follows a in the alphabet or digits.
+func isSequentialPair(a, b rune) bool {
+	switch {
+	case a >= 'a' && a <= 'y' && b == a+1:
+		return true
+	case a >= 'A' && a <= 'Y' && b == a+1:
+		return true
+	case a >= '0' && a <= '8' && b == a+1:
+		return true
+	}
+	return false
+}
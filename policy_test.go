@@ -0,0 +1,44 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyExhaustsRetriesWhenUnsatisfiable(t *testing.T) {
+	g, err := NewGenerator(
+		WithCharacters([]rune("ab"), 4),
+		WithPolicy(PolicyOptions{Exclude: []rune("ab"), MaxRetries: 2}),
+	)
+	require.Nil(t, err)
+
+	_, err = g.Generate(4, 4)
+	require.Equal(t, PolicyUnsatisfiableErr, err)
+}
+
+func TestPolicyRejectsExcessiveRepeats(t *testing.T) {
+	g, err := NewGenerator(
+		WithCharacters([]rune("a"), 4),
+		WithPolicy(PolicyOptions{MaxRepeats: 1, MaxRetries: 1}),
+	)
+	require.Nil(t, err)
+
+	_, err = g.Generate(4, 4)
+	require.Equal(t, PolicyUnsatisfiableErr, err)
+}
+
+func TestPolicyAllowsCompliantPasswords(t *testing.T) {
+	g, err := NewGenerator(
+		WithCharacters(LowerCaseAlphabet, 4),
+		WithPolicy(PolicyOptions{NoSequential: true, MaxRepeats: 2}),
+	)
+	require.Nil(t, err)
+
+	for i := 0; i < 1000; i++ {
+		p, err := g.Generate(8, 8)
+		require.Nil(t, err)
+		require.Len(t, p, 8)
+		require.True(t, g.(*generator).satisfiesPolicy(p))
+	}
+}
@@ -0,0 +1,213 @@
+package password
+
+import "strings"
+
+// Algorithm selects the strategy the generator uses to assemble passwords.
+type Algorithm int
+
+const (
+	// AlgoRandom builds passwords by drawing characters uniformly from the configured
+	// character sets. This is the default.
+	AlgoRandom Algorithm = iota
+
+	// AlgoPronounceable builds passwords out of pronounceable consonant/vowel syllables, in
+	// the style of FIPS-181 / APG's pronounceable password generator. Any WithCharacters
+	// minimums still apply: required characters are substituted into the syllable output.
+	AlgoPronounceable
+)
+
+// WithAlgorithm lets the caller select the password construction algorithm. Defaults to
+// AlgoRandom.
+func WithAlgorithm(a Algorithm) Options {
+	return func(g *generator) {
+		g.algorithm = a
+	}
+}
+
+// Syllable units used to assemble pronounceable passwords. Each unit is a short, easy to
+// pronounce run of letters; digraphs such as "ch"/"th"/"sh" and vowel pairs such as
+// "ai"/"ee" are repeated (weighted) more heavily since they read more naturally than rarer
+// combinations.
+var (
+	vowelUnits = []string{
+		"a", "a", "a", "e", "e", "e", "i", "i", "o", "o", "u",
+		"ai", "ea", "ee", "oa", "ou", "ie",
+	}
+	consonantUnits = []string{
+		"b", "c", "d", "f", "g", "h", "j", "k", "l", "l", "m", "m", "n", "n",
+		"p", "r", "r", "s", "s", "t", "t", "v", "w", "y", "z",
+		"ch", "sh", "th", "ph", "wh", "ng", "st", "tr", "cr", "br",
+	}
+	// forbiddenFollowers lists consonant units that may never directly follow themselves,
+	// since the repetition is awkward to pronounce (e.g. "hh", "ngng").
+	forbiddenFollowers = map[string]bool{
+		"h": true, "w": true, "y": true, "ng": true,
+	}
+)
+
+// generatePronounceable returns a password of exactly n runes assembled from pronounceable
+// syllable units, plus the rune-length of each unit. The lengths (rather than the unit text
+// itself) are what the caller needs to rebuild a pronunciation hint later, since
+// applyMinimums may still substitute characters into chars after this returns.
+func (g *generator) generatePronounceable(n uint) ([]rune, []int, error) {
+	var chars []rune
+	var unitLens []int
+	last := ""
+	// trailingConsonants counts the consonant runes at the very end of chars so far (0 once
+	// the last unit ended in a vowel). A CV unit's leading consonant butts directly up
+	// against this run, so pickConsonant needs it to keep a VC unit's trailing digraph (e.g.
+	// "st") plus the next CV unit's leading digraph (e.g. "ch") from exceeding two
+	// consonants in a row.
+	trailingConsonants := 0
+
+	// Alternate consonant-then-vowel and vowel-then-consonant units until the password
+	// reaches or passes the target length, then trim the final unit to fit exactly. This
+	// keeps consonant and vowel units from ever running three deep, while still varying
+	// the rhythm of the password.
+	for uint(len(chars)) < n {
+		leadConsonant, err := g.random.Get(2)
+		if err != nil {
+			return nil, nil, err
+		}
+		// A CV unit's leading consonant would butt straight up against an already-two-deep
+		// trailing run with no vowel in between, leaving pickConsonant no legal choice. Force
+		// a vowel-leading unit instead so the run breaks before it can reach three.
+		if trailingConsonants >= 2 {
+			leadConsonant = 1
+		}
+
+		var unit string
+		if leadConsonant == 0 {
+			c, err := g.pickConsonant(last, trailingConsonants)
+			if err != nil {
+				return nil, nil, err
+			}
+			v, err := g.pickVowel()
+			if err != nil {
+				return nil, nil, err
+			}
+			unit, last = c+v, ""
+			trailingConsonants = 0
+		} else {
+			v, err := g.pickVowel()
+			if err != nil {
+				return nil, nil, err
+			}
+			c, err := g.pickConsonant(last, 0)
+			if err != nil {
+				return nil, nil, err
+			}
+			unit, last = v+c, c
+			trailingConsonants = len(c)
+		}
+
+		unitLens = append(unitLens, len(unit))
+		chars = append(chars, []rune(unit)...)
+	}
+
+	if uint(len(chars)) > n {
+		chars = chars[:n]
+		unitLens = trimUnitLensTo(unitLens, n)
+	}
+
+	return chars, unitLens, nil
+}
+
+// hintFromChars rebuilds the hyphenated pronunciation hint from the final password runes
+// (after any WithCharacters substitutions), grouped using unitLens, so the hint always
+// reflects exactly what's in the password.
+func hintFromChars(chars []rune, unitLens []int) string {
+	units := make([]string, 0, len(unitLens))
+	pos := 0
+	for _, l := range unitLens {
+		units = append(units, string(chars[pos:pos+l]))
+		pos += l
+	}
+	return strings.Join(units, "-")
+}
+
+// pickConsonant returns a consonant unit, refusing to directly repeat one of the awkward
+// self-following units in forbiddenFollowers, and refusing any unit that would push the
+// combined consonant run (prevRun trailing runes plus the candidate) to 3 or more.
+func (g *generator) pickConsonant(prev string, prevRun int) (string, error) {
+	for {
+		k, err := g.random.Get(uint(len(consonantUnits)))
+		if err != nil {
+			return "", err
+		}
+		c := consonantUnits[k]
+		if prev != "" && prev == c && forbiddenFollowers[c] {
+			continue
+		}
+		if prevRun+len(c) >= 3 {
+			continue
+		}
+		return c, nil
+	}
+}
+
+// pickVowel returns a vowel unit.
+func (g *generator) pickVowel() (string, error) {
+	k, err := g.random.Get(uint(len(vowelUnits)))
+	if err != nil {
+		return "", err
+	}
+	return vowelUnits[k], nil
+}
+
+// trimUnitLensTo drops or shortens trailing unit lengths so their sum is exactly n.
+func trimUnitLensTo(unitLens []int, n uint) []int {
+	var kept []int
+	var total uint
+	for _, l := range unitLens {
+		if total+uint(l) >= n {
+			if total < n {
+				kept = append(kept, int(n-total))
+			}
+			break
+		}
+		kept = append(kept, l)
+		total += uint(l)
+	}
+	return kept
+}
+
+// applyMinimums substitutes characters from the configured charsets into chars so that each
+// set's minimum (as declared via WithCharacters) is satisfied, without disturbing the rest
+// of the pronounceable output any more than necessary.
+func (g *generator) applyMinimums(chars []rune) ([]rune, error) {
+	if len(g.charsets) == 0 {
+		return chars, nil
+	}
+
+	used := make(map[uint]bool, g.min)
+	for _, c := range g.charsets {
+		for i := uint(0); i < c.min; i++ {
+			pos, err := g.unusedPosition(used, uint(len(chars)))
+			if err != nil {
+				return nil, err
+			}
+			k, err := g.random.Get(uint(len(c.set)))
+			if err != nil {
+				return nil, err
+			}
+			chars[pos] = c.set[k]
+			used[pos] = true
+		}
+	}
+
+	return chars, nil
+}
+
+// unusedPosition returns a position in [0, n) that isn't already marked used in used.
+func (g *generator) unusedPosition(used map[uint]bool, n uint) (uint, error) {
+	for {
+		pos, err := g.random.Get(n)
+		if err != nil {
+			return 0, err
+		}
+		if !used[pos] {
+			return pos, nil
+		}
+	}
+}
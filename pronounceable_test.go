@@ -0,0 +1,49 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPronounceableGeneration(t *testing.T) {
+	g, err := NewGenerator(WithAlgorithm(AlgoPronounceable))
+	require.Nil(t, err)
+
+	for i := 0; i < 1000; i++ {
+		p, hint, err := g.GenerateWithHints(8, 16)
+		require.Nil(t, err)
+		require.GreaterOrEqual(t, len(p), 8)
+		require.LessOrEqual(t, len(p), 16)
+		require.NotEmpty(t, hint)
+		require.Equal(t, string(p), strings.ReplaceAll(hint, "-", ""))
+	}
+}
+
+func TestPronounceableHintReflectsSubstitutedMinimums(t *testing.T) {
+	g, err := NewGenerator(
+		WithAlgorithm(AlgoPronounceable),
+		WithCharacters(Digits, 3),
+		WithCharacters(UpperCaseAlphabet, 2),
+	)
+	require.Nil(t, err)
+
+	for i := 0; i < 1000; i++ {
+		p, hint, err := g.GenerateWithHints(10, 10)
+		require.Nil(t, err)
+		require.Equal(t, string(p), strings.ReplaceAll(hint, "-", ""))
+
+		numDigits, numUpper := 0, 0
+		for _, r := range p {
+			switch {
+			case r >= '0' && r <= '9':
+				numDigits++
+			case r >= 'A' && r <= 'Z':
+				numUpper++
+			}
+		}
+		require.GreaterOrEqual(t, numDigits, 3)
+		require.GreaterOrEqual(t, numUpper, 2)
+	}
+}